@@ -0,0 +1,29 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccDataSourceFunctions(t *testing.T) {
+	workspaceLevel(t, step{
+		Template: `
+		data "databricks_functions" "this" {
+			catalog_name = "main"
+			schema_name  = "default"
+		}`,
+		Check: func(s *terraform.State) error {
+			r, ok := s.RootModule().Resources["data.databricks_functions.this"]
+			if !ok {
+				return fmt.Errorf("data not found in state")
+			}
+			ids := r.Primary.Attributes["functions.#"]
+			if ids == "" {
+				return fmt.Errorf("functions is empty: %v", r.Primary.Attributes)
+			}
+			return nil
+		},
+	})
+}
@@ -0,0 +1,20 @@
+package acceptance
+
+import (
+	"testing"
+)
+
+func TestAccFunctionGrants(t *testing.T) {
+	workspaceLevel(t, step{
+		Template: `
+		resource "databricks_function_grants" "this" {
+			function      = "main.default.my_function"
+			authoritative = false
+
+			grant {
+				principal  = "account users"
+				privileges = ["EXECUTE"]
+			}
+		}`,
+	})
+}
@@ -0,0 +1,174 @@
+package catalog
+
+import (
+	"context"
+	"strings"
+
+	"github.com/databricks/databricks-sdk-go/service/catalog"
+	"github.com/databricks/terraform-provider-databricks/common"
+	pluginfwcommon "github.com/databricks/terraform-provider-databricks/internal/providers/pluginfw/common"
+	pluginfwcontext "github.com/databricks/terraform-provider-databricks/internal/providers/pluginfw/context"
+	"github.com/databricks/terraform-provider-databricks/internal/providers/pluginfw/converters"
+	"github.com/databricks/terraform-provider-databricks/internal/providers/pluginfw/tfschema"
+	"github.com/databricks/terraform-provider-databricks/internal/service/catalog_tf"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const dataSourceFunctionsName = "functions"
+
+var _ datasource.DataSourceWithConfigure = &FunctionsDataSource{}
+
+func DataSourceFunctions() datasource.DataSource {
+	return &FunctionsDataSource{}
+}
+
+// FunctionsList is the Terraform schema for the databricks_functions data source.
+type FunctionsList struct {
+	CatalogName   types.String              `tfsdk:"catalog_name"`
+	SchemaName    types.String              `tfsdk:"schema_name"`
+	NameContains  types.String              `tfsdk:"name_contains"`
+	IncludeBrowse types.Bool                `tfsdk:"include_browse"`
+	Functions     []catalog_tf.FunctionInfo `tfsdk:"functions"`
+}
+
+type FunctionsDataSource struct {
+	Client *common.DatabricksClient
+}
+
+func (d *FunctionsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = pluginfwcommon.GetDatabricksProductionName(dataSourceFunctionsName)
+}
+
+func (d *FunctionsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	functionAttrs, functionBlocks := tfschema.DataSourceStructToSchemaMap(catalog_tf.FunctionInfo{}, nil)
+	// A data source's NestedAttributeObject has no room for Blocks the way a
+	// resource schema does, so fold block-shaped fields (input_params,
+	// return_params) into computed nested attributes instead of dropping them.
+	foldBlocksIntoAttributes(functionAttrs, functionBlocks)
+
+	resp.Schema = schema.Schema{
+		Description: "Terraform schema for listing Databricks Functions within a catalog and schema",
+		Attributes: map[string]schema.Attribute{
+			"catalog_name": schema.StringAttribute{
+				Required:    true,
+				Description: "Name of the catalog the functions belong to.",
+			},
+			"schema_name": schema.StringAttribute{
+				Required:    true,
+				Description: "Name of the schema the functions belong to.",
+			},
+			"name_contains": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return functions whose name contains this substring.",
+			},
+			"include_browse": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Whether to include functions in the response for which the principal can only access selective metadata for.",
+			},
+			"functions": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "List of functions matching the criteria.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: functionAttrs,
+				},
+			},
+		},
+	}
+}
+
+// foldBlocksIntoAttributes converts block-shaped schema fields into
+// equivalent computed nested attributes and merges them into attrs,
+// recursing into any blocks nested within them.
+func foldBlocksIntoAttributes(attrs map[string]schema.Attribute, blocks map[string]schema.Block) {
+	for name, block := range blocks {
+		switch b := block.(type) {
+		case schema.ListNestedBlock:
+			nested := make(map[string]schema.Attribute, len(b.NestedObject.Attributes))
+			for k, v := range b.NestedObject.Attributes {
+				nested[k] = v
+			}
+			foldBlocksIntoAttributes(nested, b.NestedObject.Blocks)
+			attrs[name] = schema.ListNestedAttribute{
+				Computed:    true,
+				Description: b.Description,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: nested,
+				},
+			}
+		case schema.SetNestedBlock:
+			nested := make(map[string]schema.Attribute, len(b.NestedObject.Attributes))
+			for k, v := range b.NestedObject.Attributes {
+				nested[k] = v
+			}
+			foldBlocksIntoAttributes(nested, b.NestedObject.Blocks)
+			attrs[name] = schema.SetNestedAttribute{
+				Computed:    true,
+				Description: b.Description,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: nested,
+				},
+			}
+		case schema.SingleNestedBlock:
+			nested := make(map[string]schema.Attribute, len(b.Attributes))
+			for k, v := range b.Attributes {
+				nested[k] = v
+			}
+			foldBlocksIntoAttributes(nested, b.Blocks)
+			attrs[name] = schema.SingleNestedAttribute{
+				Computed:    true,
+				Description: b.Description,
+				Attributes:  nested,
+			}
+		}
+	}
+}
+
+func (d *FunctionsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if d.Client == nil && req.ProviderData != nil {
+		d.Client = pluginfwcommon.ConfigureDataSource(req, resp)
+	}
+}
+
+func (d *FunctionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx = pluginfwcontext.SetUserAgentInDataSourceContext(ctx, dataSourceFunctionsName)
+	w, diags := d.Client.GetWorkspaceClient()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var config FunctionsList
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	functionInfos, err := w.Functions.ListAll(ctx, catalog.ListFunctionsRequest{
+		CatalogName:   config.CatalogName.ValueString(),
+		SchemaName:    config.SchemaName.ValueString(),
+		IncludeBrowse: config.IncludeBrowse.ValueBool(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("failed to list functions", err.Error())
+		return
+	}
+
+	nameContains := config.NameContains.ValueString()
+	functions := make([]catalog_tf.FunctionInfo, 0, len(functionInfos))
+	for _, functionInfo := range functionInfos {
+		if nameContains != "" && !strings.Contains(functionInfo.Name, nameContains) {
+			continue
+		}
+		var tfFunctionInfo catalog_tf.FunctionInfo
+		resp.Diagnostics.Append(converters.GoSdkToTfSdkStruct(ctx, functionInfo, &tfFunctionInfo)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		functions = append(functions, tfFunctionInfo)
+	}
+	config.Functions = functions
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, config)...)
+}
@@ -2,7 +2,10 @@ package catalog
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"net/http"
+	"reflect"
 	"time"
 
 	"github.com/databricks/databricks-sdk-go"
@@ -15,23 +18,88 @@ import (
 	"github.com/databricks/terraform-provider-databricks/internal/providers/pluginfw/converters"
 	"github.com/databricks/terraform-provider-databricks/internal/providers/pluginfw/tfschema"
 	"github.com/databricks/terraform-provider-databricks/internal/service/catalog_tf"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 const resourceName = "function"
 
+// Default operation timeouts used when the practitioner does not configure a
+// `timeouts` block on the resource.
+const (
+	defaultFunctionCreateTimeout = 5 * time.Minute
+	defaultFunctionUpdateTimeout = 5 * time.Minute
+	defaultFunctionReadTimeout   = 1 * time.Minute
+	defaultFunctionDeleteTimeout = 1 * time.Minute
+)
+
 var _ resource.ResourceWithConfigure = &FunctionResource{}
 
 func ResourceFunction() resource.Resource {
 	return &FunctionResource{}
 }
 
-func waitForFunction(ctx context.Context, w *databricks.WorkspaceClient, funcInfo *catalog.FunctionInfo) diag.Diagnostics {
-	const timeout = 5 * time.Minute
+// FunctionInfo extends the generated catalog_tf.FunctionInfo with the
+// practitioner-configurable `timeouts` block and `adopt_existing` create mode.
+type FunctionInfo struct {
+	catalog_tf.FunctionInfo
+	Timeouts      timeouts.Value `tfsdk:"timeouts"`
+	AdoptExisting types.Bool     `tfsdk:"adopt_existing"`
+}
 
+// retryOnTransientError polls f until it succeeds, the timeout elapses, or f
+// returns an error that is not safe to retry. Used by the regular
+// Create/Update/Delete paths, where an "already exists"/409 is a genuine
+// failure and must surface immediately rather than be retried for the whole
+// timeout.
+func retryOnTransientError[T any](ctx context.Context, timeout time.Duration, f func() (*T, error)) (*T, error) {
+	return retries.Poll[T](ctx, timeout, func() (*T, *retries.Err) {
+		result, err := f()
+		if err != nil {
+			if apierr.IsTooManyRequests(err) {
+				return nil, retries.Continue(err)
+			}
+			return nil, retries.Halt(err)
+		}
+		return result, nil
+	})
+}
+
+// isAdoptionConflictError reports whether err is safe to retry specifically
+// while reconciling an adopt_existing function: either the workspace is
+// rate-limiting us, or the function is concurrently being mutated by another
+// operation (a 409 conflict), e.g. two adopt_existing creates racing to
+// reconcile the same remote function.
+func isAdoptionConflictError(err error) bool {
+	if apierr.IsTooManyRequests(err) {
+		return true
+	}
+	var apiErr *apierr.APIError
+	return errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusConflict
+}
+
+// retryOnAdoptionConflict is like retryOnTransientError but additionally
+// retries on a 409 conflict. It backs only the adopt_existing reconciliation
+// path (adoptExistingFunction), not the regular Create/Update/Delete calls.
+func retryOnAdoptionConflict[T any](ctx context.Context, timeout time.Duration, f func() (*T, error)) (*T, error) {
+	return retries.Poll[T](ctx, timeout, func() (*T, *retries.Err) {
+		result, err := f()
+		if err != nil {
+			if isAdoptionConflictError(err) {
+				return nil, retries.Continue(err)
+			}
+			return nil, retries.Halt(err)
+		}
+		return result, nil
+	})
+}
+
+func waitForFunction(ctx context.Context, w *databricks.WorkspaceClient, funcInfo *catalog.FunctionInfo, timeout time.Duration) diag.Diagnostics {
 	result, err := retries.Poll[catalog.FunctionInfo](ctx, timeout, func() (*catalog.FunctionInfo, *retries.Err) {
 		attempt, err := w.Functions.GetByName(ctx, funcInfo.FullName)
 		if err != nil {
@@ -67,7 +135,7 @@ func (r *FunctionResource) Schema(ctx context.Context, req resource.SchemaReques
 		c.SetRequired("input_params")
 		c.SetRequired("data_type")
 		c.SetRequired("routine_body")
-		c.SetRequired("routine_defintion")
+		c.SetRequired("routine_definition")
 		c.SetRequired("language")
 
 		c.SetReadOnly("full_name")
@@ -76,9 +144,43 @@ func (r *FunctionResource) Schema(ctx context.Context, req resource.SchemaReques
 		c.SetReadOnly("updated_at")
 		c.SetReadOnly("updated_by")
 
+		c.AddValidator("routine_body", stringvalidator.OneOf("SQL", "EXTERNAL"))
+		c.AddValidator("language", stringvalidator.OneOf("SQL", "PYTHON", "SCALA", "JAVA", "R"))
+		c.AddValidator("parameter_style", stringvalidator.OneOf("S"))
+		c.AddValidator("sql_data_access", stringvalidator.OneOf("CONTAINS_SQL", "READS_SQL_DATA", "NO_SQL"))
+		c.AddValidator("security_type", stringvalidator.OneOf("DEFINER", "INVOKER"))
+		c.AddValidator("name", stringvalidator.LengthBetween(1, 255))
+		c.AddValidator("comment", stringvalidator.LengthAtMost(1000))
+		c.AddValidator("routine_definition", stringvalidator.LengthAtLeast(1))
+
+		// The Databricks API does not support updating these fields in place,
+		// so any change must force a destroy-and-recreate. This must stay in
+		// sync with the field set adoptExistingFunction (chunk0-4) treats as
+		// immutable when deciding whether an adopted function can be
+		// reconciled in place.
+		for _, name := range []string{
+			"name", "catalog_name", "schema_name", "input_params", "return_params",
+			"data_type", "routine_body", "routine_definition", "language",
+			"parameter_style", "sql_data_access", "security_type",
+		} {
+			c.SetRequiresReplace(name)
+		}
+
 		return c
 	})
 
+	blocks["timeouts"] = timeouts.Block(ctx, timeouts.Opts{
+		Create: true,
+		Update: true,
+		Read:   true,
+		Delete: true,
+	})
+
+	attrs["adopt_existing"] = schema.BoolAttribute{
+		Optional:    true,
+		Description: "If true, adopt a function that already exists in Unity Catalog with the same full name instead of failing to create it.",
+	}
+
 	resp.Schema = schema.Schema{
 		Description: "Terraform schema for Databricks Function",
 		Attributes:  attrs,
@@ -104,12 +206,20 @@ func (r *FunctionResource) Create(ctx context.Context, req resource.CreateReques
 		return
 	}
 
-	var planFunc catalog_tf.FunctionInfo
+	var planFunc FunctionInfo
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &planFunc)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	createTimeout, diags := planFunc.Timeouts.Create(ctx, defaultFunctionCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	var createReq catalog.CreateFunctionRequest
 
 	resp.Diagnostics.Append(converters.TfSdkToGoSdkStruct(ctx, planFunc, &createReq)...)
@@ -117,15 +227,30 @@ func (r *FunctionResource) Create(ctx context.Context, req resource.CreateReques
 		return
 	}
 
-	funcInfo, err := w.Functions.Create(ctx, createReq)
-	if err != nil {
-		resp.Diagnostics.AddError("failed to create function", err.Error())
-		return
+	var funcInfo *catalog.FunctionInfo
+	if planFunc.AdoptExisting.ValueBool() {
+		adopted, err := adoptExistingFunction(ctx, w, createReq, createTimeout)
+		if err != nil {
+			resp.Diagnostics.AddError("failed to adopt existing function", err.Error())
+			return
+		}
+		funcInfo = adopted
 	}
 
-	resp.Diagnostics.Append(waitForFunction(ctx, w, funcInfo)...)
-	if resp.Diagnostics.HasError() {
-		return
+	if funcInfo == nil {
+		created, err := retryOnTransientError(ctx, createTimeout, func() (*catalog.FunctionInfo, error) {
+			return w.Functions.Create(ctx, createReq)
+		})
+		if err != nil {
+			resp.Diagnostics.AddError("failed to create function", err.Error())
+			return
+		}
+		funcInfo = created
+
+		resp.Diagnostics.Append(waitForFunction(ctx, w, funcInfo, createTimeout)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
 	}
 
 	resp.Diagnostics.Append(converters.GoSdkToTfSdkStruct(ctx, funcInfo, &planFunc)...)
@@ -136,6 +261,67 @@ func (r *FunctionResource) Create(ctx context.Context, req resource.CreateReques
 	resp.Diagnostics.Append(resp.State.Set(ctx, planFunc)...)
 }
 
+// adoptExistingFunction reconciles a function that already exists in Unity
+// Catalog (e.g. it was hand-created outside Terraform) into the plan instead
+// of failing with an "already exists" error. It returns (nil, nil) when no
+// function with the requested full name exists, so the caller can fall back
+// to the regular create path.
+func adoptExistingFunction(ctx context.Context, w *databricks.WorkspaceClient, createReq catalog.CreateFunctionRequest, timeout time.Duration) (*catalog.FunctionInfo, error) {
+	fullName := fmt.Sprintf("%s.%s.%s", createReq.FunctionInfo.CatalogName, createReq.FunctionInfo.SchemaName, createReq.FunctionInfo.Name)
+
+	existing, err := retryOnAdoptionConflict(ctx, timeout, func() (*catalog.FunctionInfo, error) {
+		return w.Functions.GetByName(ctx, fullName)
+	})
+	if err != nil {
+		if apierr.IsMissing(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	desired := createReq.FunctionInfo
+
+	// Adopting a remote function whose signature or body doesn't match the
+	// plan and silently keeping the remote values would hide a real
+	// conflict, so refuse instead of pretending the adopted function matches
+	// what was planned.
+	if functionFieldsIncompatible(existing, desired) {
+		return nil, fmt.Errorf("function %s already exists with a signature, body, or behavior that differs from this configuration; "+
+			"Databricks cannot update those fields in place, so adopt_existing cannot reconcile them automatically. "+
+			"Align the configuration with the existing function, drop it and let Terraform create a new one, or use `terraform import` instead", fullName)
+	}
+
+	if existing.Comment == desired.Comment {
+		return existing, nil
+	}
+
+	return retryOnAdoptionConflict(ctx, timeout, func() (*catalog.FunctionInfo, error) {
+		return w.Functions.Update(ctx, catalog.UpdateFunction{
+			Name:    fullName,
+			Comment: desired.Comment,
+		})
+	})
+}
+
+// functionFieldsIncompatible reports whether existing and desired differ in
+// any of the fields Schema marks RequiresReplace (data type, routine body and
+// definition, language, SQL data access, security type, parameter style,
+// input and return params), meaning adopt_existing cannot safely reconcile
+// them. Name, catalog and schema are already fixed by the full-name lookup.
+// Other fields, such as is_deterministic, is_null_call or properties, are not
+// compared.
+func functionFieldsIncompatible(existing *catalog.FunctionInfo, desired catalog.CreateFunction) bool {
+	return existing.DataType != desired.DataType ||
+		existing.RoutineBody != desired.RoutineBody ||
+		existing.Language != desired.Language ||
+		existing.RoutineDefinition != desired.RoutineDefinition ||
+		existing.SqlDataAccess != desired.SqlDataAccess ||
+		existing.SecurityType != desired.SecurityType ||
+		existing.ParameterStyle != desired.ParameterStyle ||
+		!reflect.DeepEqual(existing.InputParams, desired.InputParams) ||
+		!reflect.DeepEqual(existing.ReturnParams, desired.ReturnParams)
+}
+
 func (r *FunctionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	ctx = pluginfwcontext.SetUserAgentInResourceContext(ctx, resourceName)
 	w, diags := r.Client.GetWorkspaceClient()
@@ -144,12 +330,20 @@ func (r *FunctionResource) Update(ctx context.Context, req resource.UpdateReques
 		return
 	}
 
-	var planFunc catalog_tf.FunctionInfo
+	var planFunc FunctionInfo
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &planFunc)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	updateTimeout, diags := planFunc.Timeouts.Update(ctx, defaultFunctionUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	var updateReq catalog.UpdateFunction
 
 	resp.Diagnostics.Append(converters.TfSdkToGoSdkStruct(ctx, planFunc, &updateReq)...)
@@ -157,7 +351,9 @@ func (r *FunctionResource) Update(ctx context.Context, req resource.UpdateReques
 		return
 	}
 
-	funcInfo, err := w.Functions.Update(ctx, updateReq)
+	funcInfo, err := retryOnTransientError(ctx, updateTimeout, func() (*catalog.FunctionInfo, error) {
+		return w.Functions.Update(ctx, updateReq)
+	})
 	if err != nil {
 		resp.Diagnostics.AddError("failed to update function", err.Error())
 		return
@@ -180,13 +376,21 @@ func (r *FunctionResource) Read(ctx context.Context, req resource.ReadRequest, r
 		return
 	}
 
-	var stateFunc catalog_tf.FunctionInfo
+	var stateFunc FunctionInfo
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &stateFunc)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	readTimeout, diags := stateFunc.Timeouts.Read(ctx, defaultFunctionReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	funcName := stateFunc.Name.ValueString()
 
 	funcInfo, err := w.Functions.GetByName(ctx, funcName)
@@ -215,13 +419,29 @@ func (r *FunctionResource) Delete(ctx context.Context, req resource.DeleteReques
 		return
 	}
 
+	var stateFunc FunctionInfo
+	resp.Diagnostics.Append(req.State.Get(ctx, &stateFunc)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := stateFunc.Timeouts.Delete(ctx, defaultFunctionDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	var deleteReq catalog_tf.DeleteFunctionRequest
 	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("full_name"), &deleteReq.Name)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	err := w.Functions.DeleteByName(ctx, deleteReq.Name.ValueString())
+	_, err := retryOnTransientError(ctx, deleteTimeout, func() (*struct{}, error) {
+		return nil, w.Functions.DeleteByName(ctx, deleteReq.Name.ValueString())
+	})
 	if err != nil && !apierr.IsMissing(err) {
 		resp.Diagnostics.AddError("failed to delete function", err.Error())
 	}
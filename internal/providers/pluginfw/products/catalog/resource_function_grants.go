@@ -0,0 +1,329 @@
+package catalog
+
+import (
+	"context"
+
+	"github.com/databricks/databricks-sdk-go"
+	"github.com/databricks/databricks-sdk-go/service/catalog"
+	"github.com/databricks/terraform-provider-databricks/common"
+	pluginfwcommon "github.com/databricks/terraform-provider-databricks/internal/providers/pluginfw/common"
+	pluginfwcontext "github.com/databricks/terraform-provider-databricks/internal/providers/pluginfw/context"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const resourceFunctionGrantsName = "function_grants"
+
+var _ resource.ResourceWithConfigure = &FunctionGrantsResource{}
+
+func ResourceFunctionGrants() resource.Resource {
+	return &FunctionGrantsResource{}
+}
+
+// FunctionGrant is a single `grant` block on the databricks_function_grants resource.
+type FunctionGrant struct {
+	Principal  types.String   `tfsdk:"principal"`
+	Privileges []types.String `tfsdk:"privileges"`
+}
+
+// FunctionGrantsInfo is the Terraform schema for the databricks_function_grants resource.
+type FunctionGrantsInfo struct {
+	Function      types.String    `tfsdk:"function"`
+	Authoritative types.Bool      `tfsdk:"authoritative"`
+	Grant         []FunctionGrant `tfsdk:"grant"`
+}
+
+type FunctionGrantsResource struct {
+	Client *common.DatabricksClient
+}
+
+func (r *FunctionGrantsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = pluginfwcommon.GetDatabricksProductionName(resourceFunctionGrantsName)
+}
+
+func (r *FunctionGrantsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Terraform schema for managing EXECUTE/OWNERSHIP grants on a Databricks Unity Catalog Function",
+		Attributes: map[string]schema.Attribute{
+			"function": schema.StringAttribute{
+				Required:    true,
+				Description: "Full name of the function to manage grants for.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"authoritative": schema.BoolAttribute{
+				Optional:    true,
+				Description: "If true, grants not declared in this resource are removed from the function. If false, this resource only adds and updates the grants it declares, leaving grants managed elsewhere untouched.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"grant": schema.ListNestedBlock{
+				Description: "A principal and the set of privileges it is granted on the function.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"principal": schema.StringAttribute{
+							Required:    true,
+							Description: "User, service principal, or group name to grant privileges to.",
+						},
+						"privileges": schema.SetAttribute{
+							Required:    true,
+							ElementType: types.StringType,
+							Description: "Set of privileges to grant, e.g. EXECUTE or ALL_PRIVILEGES.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *FunctionGrantsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if r.Client == nil && req.ProviderData != nil {
+		r.Client = pluginfwcommon.ConfigureResource(req, resp)
+	}
+}
+
+func (r *FunctionGrantsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("function"), req, resp)
+}
+
+func (r *FunctionGrantsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx = pluginfwcontext.SetUserAgentInResourceContext(ctx, resourceFunctionGrantsName)
+	w, diags := r.Client.GetWorkspaceClient()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var plan FunctionGrantsInfo
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(applyFunctionGrants(ctx, w, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *FunctionGrantsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx = pluginfwcontext.SetUserAgentInResourceContext(ctx, resourceFunctionGrantsName)
+	w, diags := r.Client.GetWorkspaceClient()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var plan FunctionGrantsInfo
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(applyFunctionGrants(ctx, w, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *FunctionGrantsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx = pluginfwcontext.SetUserAgentInResourceContext(ctx, resourceFunctionGrantsName)
+	w, diags := r.Client.GetWorkspaceClient()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state FunctionGrantsInfo
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	permissions, err := w.Grants.GetBySecurableTypeAndFullName(ctx, catalog.GetGrantRequest{
+		SecurableType: catalog.SecurableTypeFunction,
+		FullName:      state.Function.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("failed to read function grants", err.Error())
+		return
+	}
+
+	// In additive mode, only surface the principals this resource declares so
+	// that grants managed elsewhere (e.g. by another `databricks_function_grants`
+	// resource or the workspace UI) don't show up as drift. That filter only
+	// applies once we actually know which principals this resource declares:
+	// on `terraform import`, state.Grant is still empty, so skip the filter
+	// and surface every remote grant instead of reporting an empty list.
+	filterToManaged := !state.Authoritative.ValueBool() && len(state.Grant) > 0
+	managedPrincipals := map[string]bool{}
+	if filterToManaged {
+		for _, grant := range state.Grant {
+			managedPrincipals[grant.Principal.ValueString()] = true
+		}
+	}
+
+	var grants []FunctionGrant
+	for _, assignment := range permissions.PrivilegeAssignments {
+		if filterToManaged && !managedPrincipals[assignment.Principal] {
+			continue
+		}
+		privileges := make([]types.String, 0, len(assignment.Privileges))
+		for _, privilege := range assignment.Privileges {
+			privileges = append(privileges, types.StringValue(string(privilege)))
+		}
+		grants = append(grants, FunctionGrant{
+			Principal:  types.StringValue(assignment.Principal),
+			Privileges: privileges,
+		})
+	}
+	state.Grant = grants
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *FunctionGrantsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx = pluginfwcontext.SetUserAgentInResourceContext(ctx, resourceFunctionGrantsName)
+	w, diags := r.Client.GetWorkspaceClient()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state FunctionGrantsInfo
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(revokeFunctionGrants(ctx, w, state)...)
+}
+
+// revokeFunctionGrants removes exactly the privileges recorded in state,
+// regardless of the authoritative flag: whether this resource owns the whole
+// grant set or only a subset, destroying it must give back every privilege
+// it ever granted, not just the ones an authoritative resource would also
+// clean up as a side effect of reconciliation.
+func revokeFunctionGrants(ctx context.Context, w *databricks.WorkspaceClient, state FunctionGrantsInfo) diag.Diagnostics {
+	var changes []catalog.PermissionsChange
+	for _, grant := range state.Grant {
+		if len(grant.Privileges) == 0 {
+			continue
+		}
+		remove := make([]catalog.Privilege, 0, len(grant.Privileges))
+		for _, privilege := range grant.Privileges {
+			remove = append(remove, catalog.Privilege(privilege.ValueString()))
+		}
+		changes = append(changes, catalog.PermissionsChange{Principal: grant.Principal.ValueString(), Remove: remove})
+	}
+
+	if len(changes) == 0 {
+		return nil
+	}
+
+	_, err := w.Grants.UpdatePermissions(ctx, catalog.UpdatePermissions{
+		SecurableType: catalog.SecurableTypeFunction,
+		FullName:      state.Function.ValueString(),
+		Changes:       changes,
+	})
+	if err != nil {
+		return diag.Diagnostics{diag.NewErrorDiagnostic("failed to revoke function grants", err.Error())}
+	}
+
+	return nil
+}
+
+// applyFunctionGrants diffs the desired grants in plan against what's
+// currently set on the function and issues the minimal set of
+// catalog.PermissionsChange needed to reconcile them. In authoritative mode,
+// principals not declared in plan are stripped of all privileges; in additive
+// mode they're left untouched.
+func applyFunctionGrants(ctx context.Context, w *databricks.WorkspaceClient, plan FunctionGrantsInfo) diag.Diagnostics {
+	fullName := plan.Function.ValueString()
+
+	current, err := w.Grants.GetBySecurableTypeAndFullName(ctx, catalog.GetGrantRequest{
+		SecurableType: catalog.SecurableTypeFunction,
+		FullName:      fullName,
+	})
+	if err != nil {
+		return diag.Diagnostics{diag.NewErrorDiagnostic("failed to read function grants", err.Error())}
+	}
+
+	desired := make(map[string]map[catalog.Privilege]bool, len(plan.Grant))
+	for _, grant := range plan.Grant {
+		privileges := make(map[catalog.Privilege]bool, len(grant.Privileges))
+		for _, privilege := range grant.Privileges {
+			privileges[catalog.Privilege(privilege.ValueString())] = true
+		}
+		desired[grant.Principal.ValueString()] = privileges
+	}
+
+	existingByPrincipal := make(map[string]map[catalog.Privilege]bool)
+	for _, assignment := range current.PrivilegeAssignments {
+		privileges := make(map[catalog.Privilege]bool, len(assignment.Privileges))
+		for _, privilege := range assignment.Privileges {
+			privileges[privilege] = true
+		}
+		existingByPrincipal[assignment.Principal] = privileges
+	}
+
+	var changes []catalog.PermissionsChange
+	for principal, privileges := range desired {
+		existing := existingByPrincipal[principal]
+		var add, remove []catalog.Privilege
+		for privilege := range privileges {
+			if !existing[privilege] {
+				add = append(add, privilege)
+			}
+		}
+		if plan.Authoritative.ValueBool() {
+			for privilege := range existing {
+				if !privileges[privilege] {
+					remove = append(remove, privilege)
+				}
+			}
+		}
+		if len(add) > 0 || len(remove) > 0 {
+			changes = append(changes, catalog.PermissionsChange{Principal: principal, Add: add, Remove: remove})
+		}
+	}
+
+	if plan.Authoritative.ValueBool() {
+		for principal, privileges := range existingByPrincipal {
+			if _, managed := desired[principal]; managed {
+				continue
+			}
+			remove := make([]catalog.Privilege, 0, len(privileges))
+			for privilege := range privileges {
+				remove = append(remove, privilege)
+			}
+			changes = append(changes, catalog.PermissionsChange{Principal: principal, Remove: remove})
+		}
+	}
+
+	if len(changes) == 0 {
+		return nil
+	}
+
+	_, err = w.Grants.UpdatePermissions(ctx, catalog.UpdatePermissions{
+		SecurableType: catalog.SecurableTypeFunction,
+		FullName:      fullName,
+		Changes:       changes,
+	})
+	if err != nil {
+		return diag.Diagnostics{diag.NewErrorDiagnostic("failed to update function grants", err.Error())}
+	}
+
+	return nil
+}
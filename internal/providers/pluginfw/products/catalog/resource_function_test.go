@@ -0,0 +1,130 @@
+package catalog
+
+import (
+	"testing"
+
+	"github.com/databricks/databricks-sdk-go/service/catalog"
+)
+
+func TestFunctionFieldsIncompatible(t *testing.T) {
+	inputParams := &catalog.FunctionParameterInfos{
+		Parameters: []catalog.FunctionParameterInfo{{Name: "x", TypeName: catalog.ColumnTypeNameInt}},
+	}
+	otherInputParams := &catalog.FunctionParameterInfos{
+		Parameters: []catalog.FunctionParameterInfo{{Name: "y", TypeName: catalog.ColumnTypeNameString}},
+	}
+
+	base := func() *catalog.FunctionInfo {
+		return &catalog.FunctionInfo{
+			DataType:          catalog.ColumnTypeNameInt,
+			RoutineBody:       catalog.RoutineBodySql,
+			Language:          "SQL",
+			RoutineDefinition: "SELECT x",
+			SqlDataAccess:     catalog.FunctionInfoSqlDataAccessContainsSql,
+			SecurityType:      catalog.FunctionInfoSecurityTypeDefiner,
+			ParameterStyle:    catalog.FunctionInfoParameterStyleS,
+			InputParams:       inputParams,
+			ReturnParams:      inputParams,
+		}
+	}
+	desiredFromExisting := func(existing *catalog.FunctionInfo) catalog.CreateFunction {
+		return catalog.CreateFunction{
+			DataType:          existing.DataType,
+			RoutineBody:       existing.RoutineBody,
+			Language:          existing.Language,
+			RoutineDefinition: existing.RoutineDefinition,
+			SqlDataAccess:     existing.SqlDataAccess,
+			SecurityType:      existing.SecurityType,
+			ParameterStyle:    existing.ParameterStyle,
+			InputParams:       existing.InputParams,
+			ReturnParams:      existing.ReturnParams,
+		}
+	}
+
+	tests := []struct {
+		name     string
+		existing *catalog.FunctionInfo
+		mutate   func(d *catalog.CreateFunction)
+		want     bool
+	}{
+		{
+			name:     "identical function is compatible",
+			existing: base(),
+			mutate:   func(d *catalog.CreateFunction) {},
+			want:     false,
+		},
+		{
+			name:     "differing data type is incompatible",
+			existing: base(),
+			mutate:   func(d *catalog.CreateFunction) { d.DataType = catalog.ColumnTypeNameString },
+			want:     true,
+		},
+		{
+			name:     "differing routine body is incompatible",
+			existing: base(),
+			mutate:   func(d *catalog.CreateFunction) { d.RoutineBody = catalog.RoutineBodyExternal },
+			want:     true,
+		},
+		{
+			name:     "differing language is incompatible",
+			existing: base(),
+			mutate:   func(d *catalog.CreateFunction) { d.Language = "PYTHON" },
+			want:     true,
+		},
+		{
+			name:     "differing routine definition is incompatible",
+			existing: base(),
+			mutate:   func(d *catalog.CreateFunction) { d.RoutineDefinition = "SELECT y" },
+			want:     true,
+		},
+		{
+			name:     "differing sql data access is incompatible",
+			existing: base(),
+			mutate:   func(d *catalog.CreateFunction) { d.SqlDataAccess = catalog.FunctionInfoSqlDataAccessNoSql },
+			want:     true,
+		},
+		{
+			name:     "differing security type is incompatible",
+			existing: base(),
+			mutate:   func(d *catalog.CreateFunction) { d.SecurityType = catalog.FunctionInfoSecurityTypeInvoker },
+			want:     true,
+		},
+		{
+			name:     "differing parameter style is incompatible",
+			existing: base(),
+			mutate:   func(d *catalog.CreateFunction) { d.ParameterStyle = "OTHER" },
+			want:     true,
+		},
+		{
+			name:     "differing input params is incompatible",
+			existing: base(),
+			mutate:   func(d *catalog.CreateFunction) { d.InputParams = otherInputParams },
+			want:     true,
+		},
+		{
+			name:     "differing return params is incompatible",
+			existing: base(),
+			mutate:   func(d *catalog.CreateFunction) { d.ReturnParams = otherInputParams },
+			want:     true,
+		},
+		{
+			name:     "differing comment alone is compatible",
+			existing: base(),
+			mutate:   func(d *catalog.CreateFunction) { d.Comment = "a different comment" },
+			want:     false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			existing := tt.existing
+			desired := desiredFromExisting(existing)
+			tt.mutate(&desired)
+
+			got := functionFieldsIncompatible(existing, desired)
+			if got != tt.want {
+				t.Errorf("functionFieldsIncompatible() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}